@@ -0,0 +1,107 @@
+package ethtxprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ABIRegistry resolves a contract's ABI by address, first checking a local
+// directory of "<address>.json" files, then falling back to fetching from a
+// Sourcify/Etherscan-style HTTP endpoint. Lookups are cached in-memory.
+type ABIRegistry struct {
+	dir    string
+	source string
+	mu     sync.Mutex
+	cache  map[common.Address]*abi.ABI
+}
+
+// NewABIRegistry builds a registry backed by dir (a directory of
+// "<address>.json" files) and/or source, a URL template containing a single
+// "%s" placeholder for the contract address. Either may be left empty.
+func NewABIRegistry(dir, source string) *ABIRegistry {
+	return &ABIRegistry{
+		dir:    dir,
+		source: source,
+		cache:  make(map[common.Address]*abi.ABI),
+	}
+}
+
+// Get returns the ABI for addr, loading it from dir or source on first use.
+func (r *ABIRegistry) Get(ctx context.Context, addr common.Address) (*abi.ABI, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if a, ok := r.cache[addr]; ok {
+		return a, nil
+	}
+
+	a, err := r.loadFromDir(addr)
+	if err != nil {
+		a, err = r.fetchFromSource(ctx, addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.cache[addr] = a
+	return a, nil
+}
+
+func (r *ABIRegistry) loadFromDir(addr common.Address) (*abi.ABI, error) {
+	if r.dir == "" {
+		return nil, fmt.Errorf("ethtxprint: no abi directory configured")
+	}
+	f, err := os.Open(filepath.Join(r.dir, addr.Hex()+".json"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	a, err := abi.JSON(f)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *ABIRegistry) fetchFromSource(ctx context.Context, addr common.Address) (*abi.ABI, error) {
+	if r.source == "" {
+		return nil, fmt.Errorf("ethtxprint: no abi source configured")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(r.source, addr.Hex()), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ethtxprint: abi source returned %s", resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload struct {
+		ABI json.RawMessage `json:"abi"`
+	}
+	raw := body
+	if err := json.Unmarshal(body, &payload); err == nil && len(payload.ABI) > 0 {
+		raw = payload.ABI
+	}
+	a, err := abi.JSON(strings.NewReader(string(raw)))
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
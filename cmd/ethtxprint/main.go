@@ -2,39 +2,83 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"math/big"
 	"os"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/juztin/ethtxprint"
 )
 
 func main() {
 	nodeURLFlag := flag.String("node", "http://localhost:8545", "Ethereum node URL")
+	formatFlag := flag.String("format", "text", "Output format: text or json")
+	traceFlag := flag.Bool("trace", false, "Trace internal calls via debug_traceTransaction")
+	abiDirFlag := flag.String("abidir", "", "Directory of <address>.json ABI files for decoding input data and logs")
+	abiSourceFlag := flag.String("abi-source", "", "Sourcify/Etherscan-style URL (with a %s address placeholder) to fetch ABIs from")
+	hashFlag := flag.String("hash", "", "Transaction hash to print")
+	blockFlag := flag.Int64("block", -1, "Block number to look up -index within (mutually exclusive with -hash)")
+	indexFlag := flag.Uint("index", 0, "Transaction index within -block")
+	priceFlag := flag.Float64("price", 0, "Static ETH price to annotate Value/Fees with, in -price-currency")
+	priceCurrencyFlag := flag.String("price-currency", "USD", "Currency -price (or -price-source) is denominated in")
+	priceSourceFlag := flag.String("price-source", "", "Coingecko-style URL (with %s symbol and %d unix-time placeholders) to fetch historical ETH prices from")
 	flag.Parse()
 
-	args := flag.Args()
-	if len(args) != 1 {
-		fmt.Println("Must supply a single transaction has argument")
+	if (*hashFlag == "") == (*blockFlag < 0) {
+		fmt.Println("Must supply exactly one of -hash or -block (with -index)")
 		os.Exit(1)
 	}
-	hash := common.HexToHash(args[0])
-	if len(hash) != 32 {
-		fmt.Println("Invalid transaction hash provided")
-		os.Exit(1)
-	}
-	c, err := ethclient.Dial(*nodeURLFlag)
+
+	rc, err := rpc.DialContext(context.Background(), *nodeURLFlag)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	tx, err := ethtxprint.NewTransaction(context.Background(), c, hash)
+	c := ethclient.NewClient(rc)
+
+	var opts []ethtxprint.Option
+	if *traceFlag {
+		opts = append(opts, ethtxprint.WithTrace(rc))
+	}
+	if *abiDirFlag != "" || *abiSourceFlag != "" {
+		opts = append(opts, ethtxprint.WithABIRegistry(ethtxprint.NewABIRegistry(*abiDirFlag, *abiSourceFlag)))
+	}
+	if *priceSourceFlag != "" {
+		opts = append(opts, ethtxprint.WithPriceOracle(ethtxprint.NewHTTPPriceOracle(*priceSourceFlag, *priceCurrencyFlag)))
+	} else if *priceFlag != 0 {
+		opts = append(opts, ethtxprint.WithPriceOracle(ethtxprint.NewStaticPriceOracle(*priceFlag, *priceCurrencyFlag)))
+	}
+
+	var tx *ethtxprint.Transaction
+	if *hashFlag != "" {
+		hash := common.HexToHash(*hashFlag)
+		if len(hash) != 32 {
+			fmt.Println("Invalid transaction hash provided")
+			os.Exit(1)
+		}
+		tx, err = ethtxprint.NewTransaction(context.Background(), c, hash, opts...)
+	} else {
+		tx, err = ethtxprint.NewTransactionAt(context.Background(), c, big.NewInt(*blockFlag), *indexFlag, opts...)
+	}
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	fmt.Println(tx)
+
+	switch *formatFlag {
+	case "json":
+		b, err := json.MarshalIndent(tx, "", "  ")
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Println(string(b))
+	default:
+		fmt.Println(tx)
+	}
 }
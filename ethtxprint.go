@@ -2,14 +2,20 @@ package ethtxprint
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/juztin/ethunit"
 )
 
@@ -39,13 +45,164 @@ func txTypeMessage(t uint8) string {
 	switch t {
 	case 0x0:
 		return "Legacy"
+	case 0x1:
+		return "EIP-2930"
 	case 0x2:
 		return "EIP-1559"
+	case 0x3:
+		return "EIP-4844"
 	default:
 		return "Unknown"
 	}
 }
 
+// InternalCall is a single frame of a debug_traceTransaction callTracer
+// result, along with any calls it made to other contracts.
+type InternalCall struct {
+	Type    string
+	From    common.Address
+	To      common.Address
+	Value   *big.Int
+	Gas     uint64
+	GasUsed uint64
+	Input   []byte
+	Output  []byte
+	Error   string
+	Calls   []*InternalCall
+}
+
+type txOptions struct {
+	rpcClient   *rpc.Client
+	abiRegistry *ABIRegistry
+	priceOracle PriceOracle
+}
+
+// Option configures optional enrichment steps performed by NewTransaction.
+type Option func(*txOptions)
+
+// WithTrace enables a debug_traceTransaction callTracer call over rc,
+// populating the "Internal Transactions" section of the printed
+// transaction. rc must be the *rpc.Client backing the same node as the
+// *ethclient.Client passed to NewTransaction (ethclient.Client keeps its own
+// *rpc.Client unexported, so callers dial their own and wrap it with
+// ethclient.NewClient to get both). Not all nodes expose the debug
+// namespace; tracing failures are reported but non-fatal.
+func WithTrace(rc *rpc.Client) Option {
+	return func(o *txOptions) { o.rpcClient = rc }
+}
+
+// WithABIRegistry enables ABI-aware decoding of the transaction's input data
+// and any receipt logs, using r to resolve a contract address to its ABI.
+func WithABIRegistry(r *ABIRegistry) Option {
+	return func(o *txOptions) { o.abiRegistry = r }
+}
+
+// WithPriceOracle enables fiat-value annotations on Value, Transaction Fee,
+// Burnt Fees and Txn Savings, priced at the transaction's block time via o.
+func WithPriceOracle(o PriceOracle) Option {
+	return func(opts *txOptions) { opts.priceOracle = o }
+}
+
+func decodeInput(a *abi.ABI, data []byte) (string, bool) {
+	if len(data) < 4 {
+		return "", false
+	}
+	m, err := a.MethodById(data[:4])
+	if err != nil {
+		return "", false
+	}
+	args, err := m.Inputs.UnpackValues(data[4:])
+	if err != nil {
+		return "", false
+	}
+	parts := make([]string, len(m.Inputs))
+	for i, in := range m.Inputs {
+		parts[i] = fmt.Sprintf("%s %s=%v", in.Type.String(), in.Name, args[i])
+	}
+	return fmt.Sprintf("%s(%s)", m.Name, strings.Join(parts, ", ")), true
+}
+
+func decodeEvent(a *abi.ABI, l *types.Log) (string, bool) {
+	if len(l.Topics) == 0 {
+		return "", false
+	}
+	e, err := a.EventByID(l.Topics[0])
+	if err != nil {
+		return "", false
+	}
+	args := make(map[string]interface{})
+	if err := a.UnpackIntoMap(args, e.Name, l.Data); err != nil {
+		return "", false
+	}
+
+	var indexed abi.Arguments
+	for _, in := range e.Inputs {
+		if in.Indexed {
+			indexed = append(indexed, in)
+		}
+	}
+	if len(indexed) > 0 {
+		if err := abi.ParseTopicsIntoMap(args, indexed, l.Topics[1:]); err != nil {
+			return "", false
+		}
+	}
+
+	parts := make([]string, 0, len(e.Inputs))
+	for _, in := range e.Inputs {
+		if v, ok := args[in.Name]; ok {
+			parts = append(parts, fmt.Sprintf("%s %s=%v", in.Type.String(), in.Name, v))
+		}
+	}
+	return fmt.Sprintf("%s(%s)", e.Name, strings.Join(parts, ", ")), true
+}
+
+type traceCallFrame struct {
+	Type    string           `json:"type"`
+	From    common.Address   `json:"from"`
+	To      common.Address   `json:"to"`
+	Value   string           `json:"value"`
+	Gas     string           `json:"gas"`
+	GasUsed string           `json:"gasUsed"`
+	Input   string           `json:"input"`
+	Output  string           `json:"output"`
+	Error   string           `json:"error"`
+	Calls   []traceCallFrame `json:"calls"`
+}
+
+func (f *traceCallFrame) toInternalCall() *InternalCall {
+	ic := &InternalCall{
+		Type:  f.Type,
+		From:  f.From,
+		To:    f.To,
+		Value: big.NewInt(0),
+		Error: f.Error,
+	}
+	if v, err := hexutil.DecodeBig(f.Value); err == nil {
+		ic.Value = v
+	}
+	if g, err := hexutil.DecodeUint64(f.Gas); err == nil {
+		ic.Gas = g
+	}
+	if g, err := hexutil.DecodeUint64(f.GasUsed); err == nil {
+		ic.GasUsed = g
+	}
+	ic.Input, _ = hexutil.Decode(f.Input)
+	ic.Output, _ = hexutil.Decode(f.Output)
+	for i := range f.Calls {
+		ic.Calls = append(ic.Calls, f.Calls[i].toInternalCall())
+	}
+	return ic
+}
+
+func traceTransaction(ctx context.Context, rc *rpc.Client, hash common.Hash) ([]*InternalCall, error) {
+	var frame traceCallFrame
+	err := rc.CallContext(ctx, &frame, "debug_traceTransaction", hash, map[string]string{"tracer": "callTracer"})
+	if err != nil {
+		return nil, err
+	}
+	return frame.toInternalCall().Calls, nil
+}
+
 type Transaction struct {
 	hash           common.Hash
 	status         status
@@ -69,9 +226,94 @@ type Transaction struct {
 	txSavings      *big.Int
 	nonce          uint64
 	data           []byte
+	accessList     types.AccessList
+	blobGasUsed    uint64
+	blobGasPrice   *big.Int
+	blobFeeCap     *big.Int
+	blobHashes     []common.Hash
+	blobFeeBurnt   *big.Int
+	trace          []*InternalCall
+	traceErr       string
+	decodedInput   string
+	decodedEvents  []string
+	priceCurrency  string
+	priceValue     *big.Float
+	priceTxFee     *big.Float
+	priceBurnt     *big.Float
+	priceSavings   *big.Float
+}
+
+func (t *Transaction) Hash() common.Hash            { return t.hash }
+func (t *Transaction) Status() string               { return t.status.String() }
+func (t *Transaction) Block() *big.Int              { return t.block }
+func (t *Transaction) BlockIndex() uint             { return t.blockIndex }
+func (t *Transaction) BlockTime() time.Time         { return t.blockTime }
+func (t *Transaction) Confirmations() uint64        { return t.confirmations }
+func (t *Transaction) From() *common.Address        { return t.from }
+func (t *Transaction) To() *common.Address          { return t.to }
+func (t *Transaction) Value() *big.Int              { return t.value }
+func (t *Transaction) TxFee() *big.Int              { return t.txFee }
+func (t *Transaction) GasPrice() *big.Int           { return t.gasPrice }
+func (t *Transaction) TxType() uint8                { return t.txType }
+func (t *Transaction) GasLimit() uint64             { return t.gasLimit }
+func (t *Transaction) GasUsed() *big.Int            { return t.gasUsed }
+func (t *Transaction) GasUsedPct() float64          { return t.gasUsedPct }
+func (t *Transaction) BaseFee() *big.Int            { return t.baseFee }
+func (t *Transaction) MaxFee() *big.Int             { return t.maxFee }
+func (t *Transaction) MaxPriorityFee() *big.Int     { return t.maxPriorityFee }
+func (t *Transaction) BurntFees() *big.Int          { return t.burntFees }
+func (t *Transaction) TxSavings() *big.Int          { return t.txSavings }
+func (t *Transaction) Nonce() uint64                { return t.nonce }
+func (t *Transaction) Data() []byte                 { return t.data }
+func (t *Transaction) AccessList() types.AccessList { return t.accessList }
+func (t *Transaction) Trace() []*InternalCall       { return t.trace }
+
+func etherValue(wei *big.Int, price *big.Float) *big.Float {
+	if wei == nil {
+		return nil
+	}
+	return new(big.Float).Mul(ethunit.WeiToEther(wei), price)
+}
+
+// txSavingsWei computes the EIP-1559 savings a sender realizes versus paying
+// maxFee for every unit of gas: (maxFee - (baseFee + maxPriorityFee)) * gasUsed.
+func txSavingsWei(gasUsed, maxFee, baseFee, maxPriorityFee *big.Int) *big.Int {
+	s := big.NewInt(0)
+	s.Mul(gasUsed, s.Sub(maxFee, s.Add(baseFee, maxPriorityFee)))
+	return s
+}
+
+// blobFeeBurntWei returns the blob gas price (defaulting to zero when the
+// node didn't report one) and the total blob fee burnt for blobGasUsed units
+// of blob gas at that price.
+func blobFeeBurntWei(blobGasUsed uint64, blobGasPrice *big.Int) (price, burnt *big.Int) {
+	if blobGasPrice == nil {
+		return big.NewInt(0), big.NewInt(0)
+	}
+	return blobGasPrice, new(big.Int).Mul(new(big.Int).SetUint64(blobGasUsed), blobGasPrice)
+}
+
+func renderInternalCalls(calls []*InternalCall, depth int) string {
+	var s string
+	indent := strings.Repeat("  ", depth)
+	for _, c := range calls {
+		s += fmt.Sprintf("\n%s%s %s -> %s (%s Ether)", indent, c.Type, c.From.String(), c.To.String(), ethunit.WeiToEther(c.Value).Text('f', -1))
+		if c.Error != "" {
+			s += fmt.Sprintf(" [reverted: %s]", c.Error)
+		}
+		s += renderInternalCalls(c.Calls, depth+1)
+	}
+	return s
 }
 
 func (t *Transaction) String() string {
+	priceSuffix := func(v *big.Float) string {
+		if v == nil || t.priceCurrency == "" {
+			return ""
+		}
+		return fmt.Sprintf(" (%s %s @ block time)", v.Text('f', 2), t.priceCurrency)
+	}
+
 	var (
 		blockMsg, blockDur, blockTime string
 		timeMsg                       string
@@ -113,57 +355,351 @@ func (t *Transaction) String() string {
 		baseFeeMsg = fmt.Sprintf("%d Wei (%s Gwei)", t.baseFee.Int64(), ethunit.WeiToGwei(t.baseFee).Text('f', -1))
 		noncePosition = strconv.FormatUint(uint64(t.blockIndex), 10)
 		var savings string
-		if t.txType == 0x2 {
-			savings = fmt.Sprintf("\nTxn Savings:              %s Ether", ethunit.WeiToEther(t.txSavings).Text('f', -1))
+		if t.txType == 0x2 || t.txType == 0x3 {
+			savings = fmt.Sprintf("\nTxn Savings:              %s Ether%s", ethunit.WeiToEther(t.txSavings).Text('f', -1), priceSuffix(t.priceSavings))
 		}
-		burnSavingsMsg = fmt.Sprintf("\nBurnt Fees:               %s Ether%s", ethunit.WeiToEther(t.burntFees).Text('f', -1), savings)
+		burnSavingsMsg = fmt.Sprintf("\nBurnt Fees:               %s Ether%s%s", ethunit.WeiToEther(t.burntFees).Text('f', -1), priceSuffix(t.priceBurnt), savings)
 	}
 
-	if t.txType == 0x2 {
+	if t.txType == 0x2 || t.txType == 0x3 {
 		maxFeeMsg = fmt.Sprintf("\nMax Fee Per Gas:          %s Ether (%s Gwei)", ethunit.WeiToEther(t.maxFee).Text('f', -1), ethunit.WeiToGwei(t.maxFee).Text('f', -1))
 		maxPriorityMsg = fmt.Sprintf("\nMax Priority Fee Per Gas: %s Ether (%s Gwei)", ethunit.WeiToEther(t.maxPriorityFee).Text('f', -1), ethunit.WeiToGwei(t.maxPriorityFee).Text('f', -1))
 	}
 
+	var accessListMsg string
+	if t.txType == 0x1 || t.txType == 0x2 || t.txType == 0x3 {
+		accessListMsg = fmt.Sprintf("\nAccess List:              %d entries", len(t.accessList))
+		for _, e := range t.accessList {
+			accessListMsg += fmt.Sprintf("\n  %s (%d storage keys)", e.Address.String(), len(e.StorageKeys))
+		}
+	}
+
+	var blobMsg string
+	if t.txType == 0x3 {
+		blobMsg = fmt.Sprintf(`
+Blob Gas Used:            %d
+Blob Gas Price:           %s Ether (%s Gwei)
+Max Fee Per Blob Gas:     %s Ether (%s Gwei)
+Blob Fee Burnt:           %s Ether`,
+			t.blobGasUsed,
+			ethunit.WeiToEther(t.blobGasPrice).Text('f', -1), ethunit.WeiToGwei(t.blobGasPrice).Text('f', -1),
+			ethunit.WeiToEther(t.blobFeeCap).Text('f', -1), ethunit.WeiToGwei(t.blobFeeCap).Text('f', -1),
+			ethunit.WeiToEther(t.blobFeeBurnt).Text('f', -1))
+		for i, h := range t.blobHashes {
+			blobMsg += fmt.Sprintf("\n  Blob %d Versioned Hash:  %s", i, h.String())
+		}
+	}
+
+	var traceMsg string
+	if t.traceErr != "" {
+		traceMsg = fmt.Sprintf("\nInternal Transactions:    (tracing unavailable: %s)", t.traceErr)
+	} else if len(t.trace) > 0 {
+		traceMsg = "\nInternal Transactions:" + renderInternalCalls(t.trace, 1)
+	}
+
+	inputMsg := fmt.Sprintf("%x", t.data)
+	if t.decodedInput != "" {
+		inputMsg = t.decodedInput
+	}
+
+	var eventsMsg string
+	if len(t.decodedEvents) > 0 {
+		eventsMsg = "\nEvents:"
+		for _, e := range t.decodedEvents {
+			eventsMsg += fmt.Sprintf("\n  %s", e)
+		}
+	}
+
 	return fmt.Sprintf(`Transaction Hash:         %s
 Block:                    %s
 Timestamp:                %s
 From:                     %s
 To:                       %s
-Value:                    %s Ether
-Transaction Fee:          %s Ether
+Value:                    %s Ether%s
+Transaction Fee:          %s Ether%s
 Gas Price:                %s Ether (%s Gwei)
 Txn Type:                 %d (%s)
 Gas Limit:                %d
 Gas Used By Transaction:  %s
-Base Fee Per Gas:         %s%s%s%s
+Base Fee Per Gas:         %s%s%s%s%s%s
 Nonce (position):         %d (%s)
-Input Data:               %x
+Input Data:               %s%s%s
 `,
 		t.hash.String(),
 		blockMsg,
 		timeMsg,
 		t.from.String(),
 		t.to.String(),
-		ethunit.WeiToGwei(t.value).Text('f', -1),
-		txFeeMsg,
+		ethunit.WeiToEther(t.value).Text('f', -1), priceSuffix(t.priceValue),
+		txFeeMsg, priceSuffix(t.priceTxFee),
 		ethunit.WeiToEther(t.gasPrice).Text('f', -1), ethunit.WeiToGwei(t.gasPrice).Text('f', -1),
 		t.txType, txTypeMessage(t.txType),
 		t.gasLimit,
 		gasUsedMsg,
-		baseFeeMsg, maxFeeMsg, maxPriorityMsg, burnSavingsMsg,
+		baseFeeMsg, maxFeeMsg, maxPriorityMsg, burnSavingsMsg, accessListMsg, blobMsg,
 		t.nonce, noncePosition,
-		t.data)
+		inputMsg, eventsMsg, traceMsg)
 }
 
-func NewTransaction(ctx context.Context, c *ethclient.Client, hash common.Hash) (*Transaction, error) {
-	t := &Transaction{hash: hash}
-	tx, pending, err := c.TransactionByHash(ctx, t.hash)
+func (t *Transaction) MarshalJSON() ([]byte, error) {
+	wei := func(v *big.Int) string {
+		if v == nil {
+			return "0"
+		}
+		return v.String()
+	}
+	ether := func(v *big.Int) string {
+		if v == nil {
+			return "0"
+		}
+		return ethunit.WeiToEther(v).Text('f', -1)
+	}
+	gwei := func(v *big.Int) string {
+		if v == nil {
+			return "0"
+		}
+		return ethunit.WeiToGwei(v).Text('f', -1)
+	}
+
+	from := ""
+	if t.from != nil {
+		from = t.from.String()
+	}
+	to := ""
+	if t.to != nil {
+		to = t.to.String()
+	}
+
+	var accessList []struct {
+		Address     string   `json:"address"`
+		StorageKeys []string `json:"storageKeys"`
+	}
+	for _, e := range t.accessList {
+		keys := make([]string, len(e.StorageKeys))
+		for i, k := range e.StorageKeys {
+			keys[i] = k.String()
+		}
+		accessList = append(accessList, struct {
+			Address     string   `json:"address"`
+			StorageKeys []string `json:"storageKeys"`
+		}{Address: e.Address.String(), StorageKeys: keys})
+	}
+
+	type blobInfo struct {
+		GasUsed      uint64   `json:"gasUsed"`
+		GasPriceWei  string   `json:"gasPriceWei"`
+		GasPriceGwei string   `json:"gasPriceGwei"`
+		FeeCapWei    string   `json:"feeCapWei"`
+		FeeCapGwei   string   `json:"feeCapGwei"`
+		FeeBurntWei  string   `json:"feeBurntWei"`
+		Hashes       []string `json:"hashes"`
+	}
+	var blob *blobInfo
+	if t.txType == 0x3 {
+		hashes := make([]string, len(t.blobHashes))
+		for i, h := range t.blobHashes {
+			hashes[i] = h.String()
+		}
+		blob = &blobInfo{
+			GasUsed:      t.blobGasUsed,
+			GasPriceWei:  wei(t.blobGasPrice),
+			GasPriceGwei: gwei(t.blobGasPrice),
+			FeeCapWei:    wei(t.blobFeeCap),
+			FeeCapGwei:   gwei(t.blobFeeCap),
+			FeeBurntWei:  wei(t.blobFeeBurnt),
+			Hashes:       hashes,
+		}
+	}
+
+	type priceInfo struct {
+		Currency  string `json:"currency"`
+		Value     string `json:"value,omitempty"`
+		TxFee     string `json:"txFee,omitempty"`
+		BurntFees string `json:"burntFees,omitempty"`
+		TxSavings string `json:"txSavings,omitempty"`
+	}
+	var price *priceInfo
+	if t.priceCurrency != "" {
+		floatStr := func(v *big.Float) string {
+			if v == nil {
+				return ""
+			}
+			return v.Text('f', 2)
+		}
+		price = &priceInfo{
+			Currency:  t.priceCurrency,
+			Value:     floatStr(t.priceValue),
+			TxFee:     floatStr(t.priceTxFee),
+			BurntFees: floatStr(t.priceBurnt),
+			TxSavings: floatStr(t.priceSavings),
+		}
+	}
+
+	return json.Marshal(struct {
+		Hash               string  `json:"hash"`
+		Status             string  `json:"status"`
+		BlockNumber        string  `json:"blockNumber"`
+		BlockIndex         uint    `json:"blockIndex"`
+		BlockTime          string  `json:"blockTime"`
+		Confirmations      uint64  `json:"confirmations"`
+		From               string  `json:"from"`
+		To                 string  `json:"to"`
+		ValueWei           string  `json:"valueWei"`
+		ValueEther         string  `json:"valueEther"`
+		GasPriceWei        string  `json:"gasPriceWei"`
+		GasPriceGwei       string  `json:"gasPriceGwei"`
+		BaseFeeWei         string  `json:"baseFeeWei"`
+		BaseFeeGwei        string  `json:"baseFeeGwei"`
+		MaxFeeWei          string  `json:"maxFeeWei"`
+		MaxFeeGwei         string  `json:"maxFeeGwei"`
+		MaxPriorityFeeWei  string  `json:"maxPriorityFeeWei"`
+		MaxPriorityFeeGwei string  `json:"maxPriorityFeeGwei"`
+		BurntFeesWei       string  `json:"burntFeesWei"`
+		BurntFeesGwei      string  `json:"burntFeesGwei"`
+		TxSavingsWei       string  `json:"txSavingsWei"`
+		TxSavingsGwei      string  `json:"txSavingsGwei"`
+		GasLimit           uint64  `json:"gasLimit"`
+		GasUsed            string  `json:"gasUsed"`
+		GasUsedPct         float64 `json:"gasUsedPct"`
+		Nonce              uint64  `json:"nonce"`
+		InputData          string  `json:"inputData"`
+		DecodedInput       string  `json:"decodedInput,omitempty"`
+		TxType             uint8   `json:"txType"`
+		TxTypeLabel        string  `json:"txTypeLabel"`
+		AccessList         []struct {
+			Address     string   `json:"address"`
+			StorageKeys []string `json:"storageKeys"`
+		} `json:"accessList,omitempty"`
+		Blob       *blobInfo       `json:"blob,omitempty"`
+		Events     []string        `json:"events,omitempty"`
+		Trace      []*InternalCall `json:"trace,omitempty"`
+		TraceError string          `json:"traceError,omitempty"`
+		Price      *priceInfo      `json:"price,omitempty"`
+	}{
+		Hash:               t.hash.String(),
+		Status:             t.status.String(),
+		BlockNumber:        wei(t.block),
+		BlockIndex:         t.blockIndex,
+		BlockTime:          t.blockTime.Format(time.RFC3339),
+		Confirmations:      t.confirmations,
+		From:               from,
+		To:                 to,
+		ValueWei:           wei(t.value),
+		ValueEther:         ether(t.value),
+		GasPriceWei:        wei(t.gasPrice),
+		GasPriceGwei:       gwei(t.gasPrice),
+		BaseFeeWei:         wei(t.baseFee),
+		BaseFeeGwei:        gwei(t.baseFee),
+		MaxFeeWei:          wei(t.maxFee),
+		MaxFeeGwei:         gwei(t.maxFee),
+		MaxPriorityFeeWei:  wei(t.maxPriorityFee),
+		MaxPriorityFeeGwei: gwei(t.maxPriorityFee),
+		BurntFeesWei:       wei(t.burntFees),
+		BurntFeesGwei:      gwei(t.burntFees),
+		TxSavingsWei:       wei(t.txSavings),
+		TxSavingsGwei:      gwei(t.txSavings),
+		GasLimit:           t.gasLimit,
+		GasUsed:            wei(t.gasUsed),
+		GasUsedPct:         t.gasUsedPct,
+		Nonce:              t.nonce,
+		InputData:          fmt.Sprintf("%x", t.data),
+		DecodedInput:       t.decodedInput,
+		TxType:             t.txType,
+		TxTypeLabel:        txTypeMessage(t.txType),
+		AccessList:         accessList,
+		Blob:               blob,
+		Events:             t.decodedEvents,
+		Trace:              t.trace,
+		TraceError:         t.traceErr,
+		Price:              price,
+	})
+}
+
+// NewTransaction fetches and enriches the transaction identified by hash.
+func NewTransaction(ctx context.Context, c *ethclient.Client, hash common.Hash, opts ...Option) (*Transaction, error) {
+	var o txOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	head, err := c.BlockByNumber(ctx, nil)
 	if err != nil {
-		return t, err
+		return &Transaction{hash: hash}, err
+	}
+
+	return newTransaction(ctx, c, hash, nil, head, nil, o)
+}
+
+// NewTransactionAt fetches and enriches the transaction at position txIndex
+// within block blockNumber, mirroring the (block number, tx index) -> tx hash
+// indexing used by block explorers.
+func NewTransactionAt(ctx context.Context, c *ethclient.Client, blockNumber *big.Int, txIndex uint, opts ...Option) (*Transaction, error) {
+	var o txOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	block, err := c.BlockByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := c.TransactionInBlock(ctx, block.Hash(), txIndex)
+	if err != nil {
+		return nil, err
 	}
 	head, err := c.BlockByNumber(ctx, nil)
 	if err != nil {
-		return t, err
+		return nil, err
+	}
+
+	return newTransaction(ctx, c, tx.Hash(), tx, head, block, o)
+}
+
+// NewBlockTransactions fetches and enriches every transaction in block
+// blockNumber, sharing a single BlockByNumber call rather than paying the N+1
+// round-trip cost of calling NewTransaction in a loop.
+func NewBlockTransactions(ctx context.Context, c *ethclient.Client, blockNumber *big.Int, opts ...Option) ([]*Transaction, error) {
+	var o txOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	block, err := c.BlockByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	head, err := c.BlockByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTxs := block.Transactions()
+	txs := make([]*Transaction, 0, len(blockTxs))
+	for _, tx := range blockTxs {
+		t, err := newTransaction(ctx, c, tx.Hash(), tx, head, block, o)
+		if err != nil {
+			return txs, err
+		}
+		txs = append(txs, t)
+	}
+	return txs, nil
+}
+
+// newTransaction performs the actual enrichment shared by NewTransaction,
+// NewTransactionAt and NewBlockTransactions. knownTx, when non-nil, is an
+// already-fetched (and therefore already-mined) transaction, sparing a
+// redundant TransactionByHash lookup. head is the current chain head, used
+// to compute confirmations. knownBlock, when non-nil, is the block
+// containing hash, sparing a redundant BlockByHash lookup.
+func newTransaction(ctx context.Context, c *ethclient.Client, hash common.Hash, knownTx *types.Transaction, head, knownBlock *types.Block, o txOptions) (*Transaction, error) {
+	t := &Transaction{hash: hash}
+	tx, pending := knownTx, false
+	var err error
+	if tx == nil {
+		tx, pending, err = c.TransactionByHash(ctx, t.hash)
+		if err != nil {
+			return t, err
+		}
 	}
 
 	t.to = tx.To()
@@ -172,23 +708,44 @@ func NewTransaction(ctx context.Context, c *ethclient.Client, hash common.Hash)
 	t.gasLimit = tx.Gas()
 	t.nonce = tx.Nonce()
 	t.data = tx.Data()
+	t.accessList = tx.AccessList()
+
+	if o.abiRegistry != nil && t.to != nil {
+		if a, err := o.abiRegistry.Get(ctx, *t.to); err == nil {
+			if s, ok := decodeInput(a, t.data); ok {
+				t.decodedInput = s
+			}
+		}
+	}
 
-	var m types.Message
+	var msg *core.Message
 	switch t.txType {
 	case 0x0:
-		m, err = tx.AsMessage(types.NewEIP155Signer(tx.ChainId()), t.maxFee)
-	case 0x2:
-		m, err = tx.AsMessage(types.NewLondonSigner(tx.ChainId()), t.maxFee)
+		msg, err = core.TransactionToMessage(tx, types.NewEIP155Signer(tx.ChainId()), t.maxFee)
+	case 0x1, 0x2:
+		msg, err = core.TransactionToMessage(tx, types.NewLondonSigner(tx.ChainId()), t.maxFee)
+	case 0x3:
+		msg, err = core.TransactionToMessage(tx, types.NewCancunSigner(tx.ChainId()), t.maxFee)
+	default:
+		// Unrecognized tx type (e.g. EIP-7702's 0x4): best-effort sender
+		// recovery with the newest known signer, degrading to a zero From
+		// rather than crashing if that also fails.
+		msg, err = core.TransactionToMessage(tx, types.NewCancunSigner(tx.ChainId()), t.maxFee)
 	}
-	if err != nil {
+	if err != nil || msg == nil {
+		zero := common.Address{}
+		t.from = &zero
 		return t, nil
 	}
-	from := m.From()
-	t.from = &from
+	t.from = &msg.From
 
 	if pending {
 		t.status = StatusPending
-		t.gasPrice = big.NewInt(0).Add(tx.GasTipCap(), head.BaseFee())
+		if t.txType == 0x2 || t.txType == 0x3 {
+			t.gasPrice = big.NewInt(0).Add(tx.GasTipCap(), head.BaseFee())
+		} else {
+			t.gasPrice = tx.GasPrice()
+		}
 		t.maxFee = big.NewInt(0)
 		t.maxPriorityFee = big.NewInt(0)
 		return t, nil
@@ -198,9 +755,24 @@ func NewTransaction(ctx context.Context, c *ethclient.Client, hash common.Hash)
 	if err != nil {
 		return t, err
 	}
-	b, err := c.BlockByHash(ctx, r.BlockHash)
-	if err != nil {
-		return t, err
+	b := knownBlock
+	if b == nil {
+		b, err = c.BlockByHash(ctx, r.BlockHash)
+		if err != nil {
+			return t, err
+		}
+	}
+
+	if o.abiRegistry != nil {
+		for _, l := range r.Logs {
+			a, err := o.abiRegistry.Get(ctx, l.Address)
+			if err != nil {
+				continue
+			}
+			if s, ok := decodeEvent(a, l); ok {
+				t.decodedEvents = append(t.decodedEvents, s)
+			}
+		}
 	}
 
 	switch r.Status {
@@ -216,7 +788,11 @@ func NewTransaction(ctx context.Context, c *ethclient.Client, hash common.Hash)
 	t.block = b.Number()
 	t.blockIndex = r.TransactionIndex
 	t.blockTime = time.Unix(int64(b.Time()), 0)
-	t.gasPrice = big.NewInt(0).Add(tx.GasTipCap(), b.BaseFee())
+	if t.txType == 0x2 || t.txType == 0x3 {
+		t.gasPrice = big.NewInt(0).Add(tx.GasTipCap(), b.BaseFee())
+	} else {
+		t.gasPrice = tx.GasPrice()
+	}
 	t.txFee = big.NewInt(0).Mul(t.gasPrice, new(big.Int).SetUint64(r.GasUsed))
 	t.baseFee = b.BaseFee()
 	t.gasUsed = new(big.Int).SetUint64(r.GasUsed)
@@ -224,11 +800,33 @@ func NewTransaction(ctx context.Context, c *ethclient.Client, hash common.Hash)
 	t.burntFees = big.NewInt(0).Mul(t.gasUsed, t.baseFee)
 	t.maxFee = tx.GasFeeCap()
 	t.maxPriorityFee = tx.GasTipCap()
-	if t.txType == 0x2 {
-		// (Max Fee Per Gas - (Base Fee Per Gas + Max Priority Fee Per Gas)) * Gas Used
-		s := big.NewInt(0)
-		s.Mul(t.gasUsed, s.Sub(t.maxFee, s.Add(t.baseFee, t.maxPriorityFee)))
-		t.txSavings = s
+	if t.txType == 0x2 || t.txType == 0x3 {
+		t.txSavings = txSavingsWei(t.gasUsed, t.maxFee, t.baseFee, t.maxPriorityFee)
+	}
+	if t.txType == 0x3 {
+		t.blobGasUsed = r.BlobGasUsed
+		t.blobFeeCap = tx.BlobGasFeeCap()
+		t.blobHashes = tx.BlobHashes()
+		t.blobGasPrice, t.blobFeeBurnt = blobFeeBurntWei(t.blobGasUsed, r.BlobGasPrice)
+	}
+
+	if o.priceOracle != nil {
+		if price, err := o.priceOracle.PriceAt(ctx, "ETH", t.blockTime); err == nil {
+			t.priceCurrency = o.priceOracle.Currency()
+			t.priceValue = etherValue(t.value, price)
+			t.priceTxFee = etherValue(t.txFee, price)
+			t.priceBurnt = etherValue(t.burntFees, price)
+			t.priceSavings = etherValue(t.txSavings, price)
+		}
+	}
+
+	if o.rpcClient != nil {
+		calls, err := traceTransaction(ctx, o.rpcClient, hash)
+		if err != nil {
+			t.traceErr = err.Error()
+		} else {
+			t.trace = calls
+		}
 	}
 
 	return t, nil
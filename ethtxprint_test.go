@@ -0,0 +1,103 @@
+package ethtxprint
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestToInternalCall(t *testing.T) {
+	tests := []struct {
+		name  string
+		frame traceCallFrame
+		want  *InternalCall
+	}{
+		{
+			name: "leaf call",
+			frame: traceCallFrame{
+				Type:    "CALL",
+				Value:   "0x2540be400",
+				Gas:     "0x5208",
+				GasUsed: "0x5208",
+				Input:   "0xa9059cbb",
+				Output:  "0x01",
+			},
+			want: &InternalCall{
+				Type:    "CALL",
+				Value:   big.NewInt(10000000000),
+				Gas:     0x5208,
+				GasUsed: 0x5208,
+				Input:   []byte{0xa9, 0x05, 0x9c, 0xbb},
+				Output:  []byte{0x01},
+			},
+		},
+		{
+			name:  "malformed hex fields default to zero",
+			frame: traceCallFrame{Type: "CALL", Value: "not-hex", Gas: "not-hex"},
+			want:  &InternalCall{Type: "CALL", Value: big.NewInt(0)},
+		},
+		{
+			name: "nested calls",
+			frame: traceCallFrame{
+				Type: "CALL",
+				Calls: []traceCallFrame{
+					{Type: "STATICCALL", Value: "0x0"},
+				},
+			},
+			want: &InternalCall{
+				Type:  "CALL",
+				Value: big.NewInt(0),
+				Calls: []*InternalCall{
+					{Type: "STATICCALL", Value: big.NewInt(0)},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.frame.toInternalCall()
+			if got.Type != tt.want.Type || got.Value.Cmp(tt.want.Value) != 0 ||
+				got.Gas != tt.want.Gas || got.GasUsed != tt.want.GasUsed ||
+				string(got.Input) != string(tt.want.Input) || string(got.Output) != string(tt.want.Output) ||
+				len(got.Calls) != len(tt.want.Calls) {
+				t.Fatalf("toInternalCall() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got.Calls {
+				if got.Calls[i].Type != tt.want.Calls[i].Type || got.Calls[i].Value.Cmp(tt.want.Calls[i].Value) != 0 {
+					t.Fatalf("toInternalCall() call %d = %+v, want %+v", i, got.Calls[i], tt.want.Calls[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTxSavingsWei(t *testing.T) {
+	tests := []struct {
+		name                                     string
+		gasUsed, maxFee, baseFee, maxPriorityFee int64
+		want                                     int64
+	}{
+		{"tip below max fee yields savings", 21000, 100, 40, 10, 1050000},
+		{"tip equals max fee minus base yields zero", 21000, 50, 40, 10, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := txSavingsWei(big.NewInt(tt.gasUsed), big.NewInt(tt.maxFee), big.NewInt(tt.baseFee), big.NewInt(tt.maxPriorityFee))
+			if got.Cmp(big.NewInt(tt.want)) != 0 {
+				t.Fatalf("txSavingsWei() = %s, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlobFeeBurntWei(t *testing.T) {
+	gotPrice, gotBurnt := blobFeeBurntWei(131072, big.NewInt(1))
+	if gotPrice.Cmp(big.NewInt(1)) != 0 || gotBurnt.Cmp(big.NewInt(131072)) != 0 {
+		t.Fatalf("blobFeeBurntWei() = (%s, %s), want (1, 131072)", gotPrice, gotBurnt)
+	}
+
+	gotPrice, gotBurnt = blobFeeBurntWei(131072, nil)
+	if gotPrice.Cmp(big.NewInt(0)) != 0 || gotBurnt.Cmp(big.NewInt(0)) != 0 {
+		t.Fatalf("blobFeeBurntWei() with nil price = (%s, %s), want (0, 0)", gotPrice, gotBurnt)
+	}
+}
@@ -0,0 +1,101 @@
+package ethtxprint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// PriceOracle resolves the fiat value of one unit of symbol (e.g. "ETH") at
+// time t, along with the currency that value is denominated in.
+type PriceOracle interface {
+	PriceAt(ctx context.Context, symbol string, t time.Time) (*big.Float, error)
+	Currency() string
+}
+
+// StaticPriceOracle returns a fixed price for every lookup.
+type StaticPriceOracle struct {
+	price    *big.Float
+	currency string
+}
+
+// NewStaticPriceOracle returns a PriceOracle that always reports price,
+// denominated in currency.
+func NewStaticPriceOracle(price float64, currency string) *StaticPriceOracle {
+	return &StaticPriceOracle{price: big.NewFloat(price), currency: currency}
+}
+
+func (o *StaticPriceOracle) PriceAt(ctx context.Context, symbol string, t time.Time) (*big.Float, error) {
+	return o.price, nil
+}
+
+func (o *StaticPriceOracle) Currency() string { return o.currency }
+
+// HTTPPriceOracle queries a configurable Coingecko-style endpoint for the
+// fiat price of symbol at time t. url must contain a "%s" placeholder for
+// the symbol and a "%d" placeholder for the Unix timestamp. Results are
+// cached in-memory keyed by (symbol, unix-hour) to avoid refetching the same
+// price repeatedly during a batch run.
+type HTTPPriceOracle struct {
+	url      string
+	currency string
+
+	mu    sync.Mutex
+	cache map[string]*big.Float
+}
+
+// NewHTTPPriceOracle returns a PriceOracle backed by url, reporting prices
+// denominated in currency.
+func NewHTTPPriceOracle(url, currency string) *HTTPPriceOracle {
+	return &HTTPPriceOracle{url: url, currency: currency, cache: make(map[string]*big.Float)}
+}
+
+func (o *HTTPPriceOracle) Currency() string { return o.currency }
+
+func (o *HTTPPriceOracle) PriceAt(ctx context.Context, symbol string, t time.Time) (*big.Float, error) {
+	key := fmt.Sprintf("%s:%d", symbol, t.Unix()/3600)
+
+	o.mu.Lock()
+	price, ok := o.cache[key]
+	o.mu.Unlock()
+	if ok {
+		return price, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(o.url, symbol, t.Unix()), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ethtxprint: price source returned %s", resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload map[string]map[string]float64
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	v, ok := payload[symbol][o.currency]
+	if !ok {
+		return nil, fmt.Errorf("ethtxprint: price source has no %s price in %s", symbol, o.currency)
+	}
+	price = big.NewFloat(v)
+
+	o.mu.Lock()
+	o.cache[key] = price
+	o.mu.Unlock()
+	return price, nil
+}